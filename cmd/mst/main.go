@@ -0,0 +1,51 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/wirepair/algorithms/graph"
+	"github.com/wirepair/algorithms/helpers"
+)
+
+var filename string
+var algorithm string
+
+func init() {
+	flag.StringVar(&filename, "f", "stdin", "filename or stdin.")
+	flag.StringVar(&algorithm, "a", "kruskal", "mst algorithm: kruskal or boruvka")
+}
+
+func main() {
+	var err error
+	var input *os.File
+	flag.Parse()
+	if filename == "stdin" {
+		input = os.Stdin
+	} else if input, err = os.Open(filename); err != nil {
+		log.Fatal(err)
+	}
+	fmt.Printf("Opened %s for input.\n", filename)
+
+	helper := helpers.New(input)
+	n, edges, err := graph.EdgeReader(helper)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var tree []graph.Edge
+	var totalWeight float64
+	switch algorithm {
+	case "kruskal":
+		tree, totalWeight = graph.Kruskal(n, edges)
+	case "boruvka":
+		tree, totalWeight = graph.Boruvka(n, edges)
+	default:
+		log.Fatal("error must choose an algorithm of kruskal or boruvka")
+	}
+
+	fmt.Printf("Using %s over %d sites and %d edges.\n", algorithm, n, len(edges))
+	fmt.Printf("%d edges in tree, total weight %v.\n", len(tree), totalWeight)
+}
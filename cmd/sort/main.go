@@ -0,0 +1,105 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"github.com/wirepair/algorithms/helpers"
+	"github.com/wirepair/algorithms/sorting"
+	"log"
+	"os"
+	"strings"
+)
+
+var filename string
+var sortType string
+var outfile string
+var chunkSize int
+var parallel int
+var elemKind string
+
+func init() {
+	flag.StringVar(&filename, "f", "stdin", "filename or stdin.")
+	flag.StringVar(&sortType, "s", "pdq", "sort type: pdq, selection, insertion, shell, merge, quick, external.")
+	flag.StringVar(&outfile, "o", "stdout", "output file for -s external, or stdout.")
+	flag.IntVar(&chunkSize, "chunk", 1<<20, "elements per run for -s external.")
+	flag.IntVar(&parallel, "parallel", 0, "workers sorting runs concurrently for -s external (0: GOMAXPROCS).")
+	flag.StringVar(&elemKind, "k", "string", "element kind for -s external: int or string.")
+}
+
+// lessString is the Sort/SortFunc comparator for plain string order.
+func lessString(a, b string) int {
+	return strings.Compare(a, b)
+}
+
+func main() {
+	var err error
+	var input *os.File
+
+	flag.Parse()
+	if filename == "stdin" {
+		input = os.Stdin
+	} else if input, err = os.Open(filename); err != nil {
+		log.Fatal(err)
+	}
+
+	helper := helpers.New(input)
+	strChan := make(chan string)
+	go helper.GetString(strChan)
+
+	if sortType == "external" {
+		if err := runExternal(strChan); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
+	data := make([]string, 0)
+	for v := range strChan {
+		data = append(data, v)
+	}
+	fmt.Printf("we got our data: %v\n", data)
+
+	if sortType == "pdq" {
+		sorting.Sort(data, lessString)
+	} else {
+		legacy := make(sorting.StringSlice, len(data))
+		copy(legacy, data)
+		sorting.SortWith(legacyAlgorithm(sortType), legacy)
+		copy(data, legacy)
+	}
+
+	fmt.Printf("...and sorted: %v", data)
+}
+
+// runExternal streams strChan straight into sorting.ExternalSort instead of
+// draining it into a slice first, so inputs larger than memory can be
+// sorted in bounded chunks.
+func runExternal(strChan <-chan string) error {
+	w := os.Stdout
+	if outfile != "stdout" {
+		f, err := os.Create(outfile)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		w = f
+	}
+	return sorting.ExternalSort(strChan, elemKind, chunkSize, parallel, os.TempDir(), w)
+}
+
+// legacyAlgorithm maps the -s flag onto one of the pedagogical Sorter-based
+// algorithms kept around for comparison against the pdqsort default.
+func legacyAlgorithm(sortType string) sorting.Algorithm {
+	switch sortType {
+	case "insertion":
+		return sorting.Insertion
+	case "shell":
+		return sorting.Shell
+	case "merge":
+		return sorting.Merge
+	case "quick":
+		return sorting.Quick
+	default:
+		return sorting.Selection
+	}
+}
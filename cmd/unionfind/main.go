@@ -0,0 +1,81 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"github.com/wirepair/algorithms/helpers"
+	"github.com/wirepair/algorithms/unionfind"
+	"log"
+	"os"
+)
+
+var filename string
+var ufType string
+
+func init() {
+	flag.StringVar(&filename, "f", "stdin", "filename or stdin.")
+	flag.StringVar(&ufType, "u", "weighted", "unionfind type: quickfind, quickunion, weighted, pathcompressed, rank, rollback")
+}
+
+// Creates a UnionFinder based on the requested type.
+func getFinder() unionfind.UnionFinder {
+	var uf unionfind.UnionFinder
+	switch ufType {
+	case "weighted":
+		uf = new(unionfind.WeightedQuickUnion)
+	case "quickfind":
+		uf = new(unionfind.UnionQuickFind)
+	case "quickunion":
+		uf = new(unionfind.QuickUnionFind)
+	case "pathcompressed":
+		uf = new(unionfind.PathCompressedUF)
+	case "rank":
+		uf = new(unionfind.UnionByRank)
+	case "rollback":
+		uf = new(unionfind.RollbackUF)
+	default:
+		log.Fatal("error must choose a type of quickfind, quickunion, weighted, pathcompressed, rank or rollback")
+	}
+	return uf
+}
+
+func main() {
+	var err error
+	var input *os.File
+	flag.Parse()
+	if filename == "stdin" {
+		input = os.Stdin
+	} else if input, err = os.Open(filename); err != nil {
+		log.Fatal(err)
+		return
+	}
+	fmt.Printf("Opened %s for input.\n", filename)
+
+	helper := helpers.New(input)
+	sites, err := helper.GetSites()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	uf := getFinder()
+	fmt.Printf("Using unionfind of type %s.\n", ufType)
+
+	uf.Init(sites)
+
+	intChan := make(chan int64)
+
+	go func() {
+		helper.GetInt(intChan)
+	}()
+
+	for p := range intChan {
+		q := <-intChan
+		if uf.Connected(p, q) {
+			continue
+		}
+		uf.Union(p, q)
+
+	}
+	fmt.Printf("%d components.\n", uf.Count())
+	helper.Close()
+}
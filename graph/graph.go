@@ -0,0 +1,133 @@
+// Package graph implements minimum spanning tree algorithms on top of the
+// unionfind and sorting packages.
+package graph
+
+import (
+	"github.com/wirepair/algorithms/helpers"
+	"github.com/wirepair/algorithms/sorting"
+	"github.com/wirepair/algorithms/unionfind"
+)
+
+// Edge is a weighted, undirected edge between sites U and V.
+type Edge struct {
+	U, V   int64
+	Weight float64
+}
+
+// EdgeReader parses the "N M\nu v w\n..." edge-list format from a
+// helpers.Helper: N sites, M edges, followed by M (u, v, weight) triples.
+func EdgeReader(helper *helpers.Helper) (n int64, edges []Edge, err error) {
+	if n, err = helper.GetSites(); err != nil {
+		return 0, nil, err
+	}
+	m, err := helper.NextInt()
+	if err != nil {
+		return 0, nil, err
+	}
+	edges = make([]Edge, 0, m)
+	for i := int64(0); i < m; i++ {
+		u, err := helper.NextInt()
+		if err != nil {
+			return 0, nil, err
+		}
+		v, err := helper.NextInt()
+		if err != nil {
+			return 0, nil, err
+		}
+		w, err := helper.NextFloat()
+		if err != nil {
+			return 0, nil, err
+		}
+		edges = append(edges, Edge{U: u, V: v, Weight: w})
+	}
+	return n, edges, nil
+}
+
+// Kruskal computes a minimum spanning tree (or forest, if the graph is
+// disconnected) over n sites: sort edges by weight, then greedily union
+// the endpoints of each edge that doesn't already connect the same
+// component.
+func Kruskal(n int64, edges []Edge) (tree []Edge, totalWeight float64) {
+	if n <= 1 {
+		return nil, 0
+	}
+	sorted := make([]Edge, len(edges))
+	copy(sorted, edges)
+	sorting.Sort(sorted, func(a, b Edge) int {
+		switch {
+		case a.Weight < b.Weight:
+			return -1
+		case a.Weight > b.Weight:
+			return 1
+		default:
+			return 0
+		}
+	})
+
+	uf := new(unionfind.WeightedQuickUnion)
+	uf.Init(n)
+
+	tree = make([]Edge, 0, n-1)
+	for _, e := range sorted {
+		if uf.Find(e.U) == uf.Find(e.V) {
+			continue
+		}
+		uf.Union(e.U, e.V)
+		tree = append(tree, e)
+		totalWeight += e.Weight
+		if int64(len(tree)) == n-1 {
+			break
+		}
+	}
+	return tree, totalWeight
+}
+
+// Boruvka computes a minimum spanning tree/forest the same way Kruskal
+// does, but via Boruvka's algorithm: each round, every component adds its
+// single cheapest outgoing edge, so the number of components at least
+// halves per round. It's included alongside Kruskal for benchmarking on
+// dense inputs, where skipping the up-front sort of the whole edge list
+// pays off.
+func Boruvka(n int64, edges []Edge) (tree []Edge, totalWeight float64) {
+	if n <= 1 {
+		return nil, 0
+	}
+	uf := new(unionfind.WeightedQuickUnion)
+	uf.Init(n)
+
+	tree = make([]Edge, 0, n-1)
+	for int64(len(tree)) < n-1 {
+		cheapest := make(map[int64]int) // component root -> index of its cheapest outgoing edge.
+		for i, e := range edges {
+			rootU := uf.Find(e.U)
+			rootV := uf.Find(e.V)
+			if rootU == rootV {
+				continue
+			}
+			if j, ok := cheapest[rootU]; !ok || edges[j].Weight > e.Weight {
+				cheapest[rootU] = i
+			}
+			if j, ok := cheapest[rootV]; !ok || edges[j].Weight > e.Weight {
+				cheapest[rootV] = i
+			}
+		}
+		if len(cheapest) == 0 {
+			break // no edges cross a component boundary: graph is disconnected.
+		}
+		added := make(map[int]bool)
+		for _, i := range cheapest {
+			if added[i] {
+				continue
+			}
+			e := edges[i]
+			if uf.Find(e.U) == uf.Find(e.V) {
+				continue
+			}
+			uf.Union(e.U, e.V)
+			tree = append(tree, e)
+			totalWeight += e.Weight
+			added[i] = true
+		}
+	}
+	return tree, totalWeight
+}
@@ -0,0 +1,125 @@
+package graph
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/wirepair/algorithms/unionfind"
+)
+
+// weightsEqual compares two MST weights within a small epsilon: Kruskal and
+// Boruvka sum the same set of edge weights in different orders, so exact
+// equality can fail on floating-point rounding alone.
+func weightsEqual(a, b float64) bool {
+	return math.Abs(a-b) < 1e-9
+}
+
+// bruteForceMST computes the minimum spanning forest weight by trying edges
+// in increasing weight order and unioning with a plain WeightedQuickUnion,
+// the same greedy argument Kruskal relies on, but written independently so
+// it can serve as a reference.
+func bruteForceMST(n int64, edges []Edge) float64 {
+	if n <= 1 {
+		return 0
+	}
+	sorted := append([]Edge(nil), edges...)
+	for i := 1; i < len(sorted); i++ {
+		for j := i; j > 0 && sorted[j].Weight < sorted[j-1].Weight; j-- {
+			sorted[j], sorted[j-1] = sorted[j-1], sorted[j]
+		}
+	}
+
+	uf := new(unionfind.WeightedQuickUnion)
+	uf.Init(n)
+	var total float64
+	edgeCount := int64(0)
+	for _, e := range sorted {
+		if uf.Find(e.U) == uf.Find(e.V) {
+			continue
+		}
+		uf.Union(e.U, e.V)
+		total += e.Weight
+		edgeCount++
+		if edgeCount == n-1 {
+			break
+		}
+	}
+	return total
+}
+
+func randomGraph(rng *rand.Rand, n int64, m int) []Edge {
+	if n <= 0 {
+		return nil
+	}
+	edges := make([]Edge, m)
+	for i := range edges {
+		edges[i] = Edge{U: rng.Int63n(n), V: rng.Int63n(n), Weight: rng.Float64() * 100}
+	}
+	return edges
+}
+
+func TestKruskalMatchesBruteForce(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for trial := 0; trial < 200; trial++ {
+		n := int64(1 + rng.Intn(20))
+		m := rng.Intn(40)
+		edges := randomGraph(rng, n, m)
+
+		want := bruteForceMST(n, edges)
+		_, got := Kruskal(n, edges)
+		if !weightsEqual(got, want) {
+			t.Fatalf("trial %d (n=%d, m=%d): Kruskal weight = %v, want %v", trial, n, m, got, want)
+		}
+	}
+}
+
+func TestBoruvkaMatchesBruteForce(t *testing.T) {
+	rng := rand.New(rand.NewSource(2))
+	for trial := 0; trial < 200; trial++ {
+		n := int64(1 + rng.Intn(20))
+		m := rng.Intn(40)
+		edges := randomGraph(rng, n, m)
+
+		want := bruteForceMST(n, edges)
+		_, got := Boruvka(n, edges)
+		if !weightsEqual(got, want) {
+			t.Fatalf("trial %d (n=%d, m=%d): Boruvka weight = %v, want %v", trial, n, m, got, want)
+		}
+	}
+}
+
+func TestKruskalBoruvkaSmallAndEmptyInputs(t *testing.T) {
+	cases := []struct {
+		name  string
+		n     int64
+		edges []Edge
+	}{
+		{"n=0", 0, nil},
+		{"n=1", 1, nil},
+		{"n=1 with self edge", 1, []Edge{{U: 0, V: 0, Weight: 5}}},
+		{"n=5 no edges (disconnected)", 5, nil},
+		{"n=5 one edge (disconnected)", 5, []Edge{{U: 0, V: 1, Weight: 3}}},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			want := bruteForceMST(c.n, c.edges)
+
+			treeK, weightK := Kruskal(c.n, c.edges)
+			if !weightsEqual(weightK, want) {
+				t.Errorf("Kruskal weight = %v, want %v", weightK, want)
+			}
+			if len(treeK) > 0 && c.n <= 1 {
+				t.Errorf("Kruskal returned a non-empty tree for n=%d: %v", c.n, treeK)
+			}
+
+			treeB, weightB := Boruvka(c.n, c.edges)
+			if !weightsEqual(weightB, want) {
+				t.Errorf("Boruvka weight = %v, want %v", weightB, want)
+			}
+			if len(treeB) > 0 && c.n <= 1 {
+				t.Errorf("Boruvka returned a non-empty tree for n=%d: %v", c.n, treeB)
+			}
+		})
+	}
+}
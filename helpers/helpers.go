@@ -11,6 +11,8 @@ type Helperers interface {
 	GetSites() (int64, error)
 	GetInt(intChan chan int64)
 	GetString(strChan chan string)
+	NextInt() (int64, error)
+	NextFloat() (float64, error)
 	Close()
 }
 
@@ -27,10 +29,23 @@ func New(file *os.File) *Helper {
 }
 
 func (h *Helper) GetSites() (int64, error) {
+	return h.NextInt()
+}
+
+// NextInt reads and parses the next whitespace-delimited token as an int64,
+// for callers that need to interleave different token types (e.g. reading
+// an "N M" header before looping over mixed int/float edge records).
+func (h *Helper) NextInt() (int64, error) {
 	h.scanner.Scan()
 	return strconv.ParseInt(h.scanner.Text(), 10, 32)
 }
 
+// NextFloat reads and parses the next whitespace-delimited token as a float64.
+func (h *Helper) NextFloat() (float64, error) {
+	h.scanner.Scan()
+	return strconv.ParseFloat(h.scanner.Text(), 64)
+}
+
 func (h *Helper) GetInt(intChan chan<- int64) {
 	h.scanner.Split(bufio.ScanWords)
 	for h.scanner.Scan() {
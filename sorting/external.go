@@ -0,0 +1,276 @@
+package sorting
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"runtime"
+	"strconv"
+	"sync"
+)
+
+// Comparable is a single record the external merge sort can compare and
+// round-trip through a spilled run file as text.
+type Comparable interface {
+	Less(other Comparable) bool
+	String() string
+}
+
+type IntComparable int
+
+func (i IntComparable) Less(other Comparable) bool { return int(i) < int(other.(IntComparable)) }
+func (i IntComparable) String() string             { return strconv.Itoa(int(i)) }
+
+type StringComparable string
+
+func (s StringComparable) Less(other Comparable) bool {
+	return string(s) < string(other.(StringComparable))
+}
+func (s StringComparable) String() string { return string(s) }
+
+// ParseComparable parses a raw token into the Comparable kind requested
+// ("int" or "string"; anything else defaults to string).
+func ParseComparable(kind, token string) (Comparable, error) {
+	if kind == "int" {
+		v, err := strconv.Atoi(token)
+		if err != nil {
+			return nil, err
+		}
+		return IntComparable(v), nil
+	}
+	return StringComparable(token), nil
+}
+
+// ExternalSort drains values in fixed-size chunks, sorts each chunk with
+// pdqsort (spreading the chunks across up to parallel concurrent workers),
+// spills each sorted chunk to a temp file under dir, then k-way merges the
+// resulting runs into out. It never holds more than chunkSize elements per
+// worker in memory, which is the point: it exists for inputs too large to
+// sort with the in-memory Sort.
+func ExternalSort(values <-chan string, kind string, chunkSize, parallel int, dir string, out io.Writer) error {
+	if chunkSize <= 0 {
+		chunkSize = 1
+	}
+	if parallel <= 0 {
+		parallel = runtime.GOMAXPROCS(0)
+	}
+
+	var (
+		mu       sync.Mutex
+		wg       sync.WaitGroup
+		runFiles []string
+		firstErr error
+	)
+	sem := make(chan struct{}, parallel)
+
+	recordErr := func(err error) {
+		mu.Lock()
+		if firstErr == nil {
+			firstErr = err
+		}
+		mu.Unlock()
+	}
+
+	flush := func(tokens []string) {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+			path, err := sortChunkToFile(tokens, kind, dir)
+			if err != nil {
+				recordErr(err)
+				return
+			}
+			mu.Lock()
+			runFiles = append(runFiles, path)
+			mu.Unlock()
+		}()
+	}
+
+	chunk := make([]string, 0, chunkSize)
+	for v := range values {
+		chunk = append(chunk, v)
+		if len(chunk) == chunkSize {
+			flush(chunk)
+			chunk = make([]string, 0, chunkSize)
+		}
+	}
+	if len(chunk) > 0 {
+		flush(chunk)
+	}
+	wg.Wait()
+
+	defer func() {
+		for _, f := range runFiles {
+			os.Remove(f)
+		}
+	}()
+
+	if firstErr != nil {
+		return firstErr
+	}
+	return mergeRuns(runFiles, kind, out)
+}
+
+func sortChunkToFile(tokens []string, kind, dir string) (string, error) {
+	elems := make([]Comparable, len(tokens))
+	for i, tok := range tokens {
+		c, err := ParseComparable(kind, tok)
+		if err != nil {
+			return "", err
+		}
+		elems[i] = c
+	}
+	Sort(elems, func(a, b Comparable) int {
+		switch {
+		case a.Less(b):
+			return -1
+		case b.Less(a):
+			return 1
+		default:
+			return 0
+		}
+	})
+
+	f, err := os.CreateTemp(dir, "sortrun-*.txt")
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	w := bufio.NewWriter(f)
+	for _, e := range elems {
+		fmt.Fprintln(w, e.String())
+	}
+	if err := w.Flush(); err != nil {
+		os.Remove(f.Name())
+		return "", err
+	}
+	return f.Name(), nil
+}
+
+// runReader streams one already-sorted spilled run back in, one record
+// ahead (cur holds the next unread record, if any).
+type runReader struct {
+	scanner *bufio.Scanner
+	file    *os.File
+	kind    string
+	cur     Comparable
+	ok      bool
+}
+
+func newRunReader(path, kind string) (*runReader, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	r := &runReader{scanner: bufio.NewScanner(f), file: f, kind: kind}
+	r.advance()
+	return r, nil
+}
+
+func (r *runReader) advance() {
+	if !r.scanner.Scan() {
+		r.ok = false
+		return
+	}
+	c, err := ParseComparable(r.kind, r.scanner.Text())
+	if err != nil {
+		r.ok = false
+		return
+	}
+	r.cur = c
+	r.ok = true
+}
+
+func (r *runReader) close() {
+	r.file.Close()
+}
+
+// runHeap is an indexed binary min-heap of run readers, keyed by each
+// reader's current head record, so the k-way merge can pick the next
+// smallest record in O(log k) instead of scanning every run.
+type runHeap struct {
+	readers []*runReader
+}
+
+func (h *runHeap) Len() int { return len(h.readers) }
+
+func (h *runHeap) less(i, j int) bool { return h.readers[i].cur.Less(h.readers[j].cur) }
+
+func (h *runHeap) swap(i, j int) { h.readers[i], h.readers[j] = h.readers[j], h.readers[i] }
+
+func (h *runHeap) push(r *runReader) {
+	h.readers = append(h.readers, r)
+	h.siftUp(len(h.readers) - 1)
+}
+
+func (h *runHeap) siftUp(i int) {
+	for i > 0 {
+		parent := (i - 1) / 2
+		if !h.less(i, parent) {
+			return
+		}
+		h.swap(i, parent)
+		i = parent
+	}
+}
+
+func (h *runHeap) siftDown(i int) {
+	n := len(h.readers)
+	for {
+		left, right := 2*i+1, 2*i+2
+		smallest := i
+		if left < n && h.less(left, smallest) {
+			smallest = left
+		}
+		if right < n && h.less(right, smallest) {
+			smallest = right
+		}
+		if smallest == i {
+			return
+		}
+		h.swap(i, smallest)
+		i = smallest
+	}
+}
+
+// pop removes and returns the reader currently holding the smallest record.
+func (h *runHeap) pop() *runReader {
+	n := len(h.readers)
+	top := h.readers[0]
+	h.readers[0] = h.readers[n-1]
+	h.readers = h.readers[:n-1]
+	if len(h.readers) > 0 {
+		h.siftDown(0)
+	}
+	return top
+}
+
+// mergeRuns k-way merges the sorted run files at paths into out.
+func mergeRuns(paths []string, kind string, out io.Writer) error {
+	h := &runHeap{}
+	for _, p := range paths {
+		r, err := newRunReader(p, kind)
+		if err != nil {
+			return err
+		}
+		defer r.close()
+		if r.ok {
+			h.push(r)
+		}
+	}
+
+	w := bufio.NewWriter(out)
+	for h.Len() > 0 {
+		r := h.pop()
+		fmt.Fprintln(w, r.cur.String())
+		r.advance()
+		if r.ok {
+			h.push(r)
+		}
+	}
+	return w.Flush()
+}
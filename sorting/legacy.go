@@ -1,12 +1,4 @@
-package main
-
-import (
-	"flag"
-	"fmt"
-	"github.com/wirepair/algorithms/helpers"
-	"log"
-	"os"
-)
+package sorting
 
 // Sorter represents an object which is sortable
 // by being able to compare / exchange values.
@@ -62,25 +54,34 @@ func (s StringSlice) Get(i int) interface{} {
 	return s[i]
 }
 
-// A sort function which takes in a Sorter and sorts the data.
-type SortFunc func(data Sorter)
+// Algorithm identifies one of the pedagogical Sorter-based implementations
+// kept around for comparison against the generic pdqsort default.
+type Algorithm int
 
-// Returns the specified sort function, returning SelectionSort as the default
-func GetSortFunc(sortType string) SortFunc {
-	switch sortType {
-	case "insertion":
-		return InsertionSort
-	case "selection":
-		return SelectionSort
-	case "shell":
-		return ShellSort
-	case "merge":
-		return MergeSort
-	case "quick":
-		return QuickSort
+const (
+	Selection Algorithm = iota
+	Insertion
+	Shell
+	Merge
+	Quick
+)
+
+// SortWith runs data through one of the classic Sorter-based algorithms,
+// kept around for teaching/benchmarking purposes now that Sort/SortFunc use
+// pdqsort by default.
+func SortWith(algo Algorithm, data Sorter) {
+	switch algo {
+	case Insertion:
+		InsertionSort(data)
+	case Shell:
+		ShellSort(data)
+	case Merge:
+		MergeSort(data)
+	case Quick:
+		QuickSort(data)
+	default:
+		SelectionSort(data)
 	}
-	// default.
-	return SelectionSort
 }
 
 func SelectionSort(data Sorter) {
@@ -105,7 +106,6 @@ func insertionSort(data Sorter, lo, hi int) {
 		// insert data[i] amoung data[i-1], data[i-2], data[i-3]...
 		for j := i; j > lo && data.Less(j, j-1); j-- {
 			data.Exch(j, j-1)
-			//fmt.Printf("%v\n", data)
 		}
 	}
 }
@@ -116,7 +116,6 @@ func ShellSort(data Sorter) {
 	// Increment sequence. Why 3*h+1? Because math.
 	for h < N/3 {
 		h = 3*h + 1 // 1, 4, 13, 40, 121, 364, 1093, ...
-		fmt.Printf("values in %s of size: %d make h: %d\n", filename, N, h)
 	}
 	for h >= 1 {
 		// h-sort the aray
@@ -153,17 +152,13 @@ func mergeSort(src, dst Sorter, lo, hi int) {
 	}
 	mid := lo + (hi-lo)/2
 	mergeSort(dst, src, lo, mid)
-	fmt.Printf("Src 1st:\n%v\n", src)
 	mergeSort(dst, src, mid+1, hi)
-	fmt.Printf("Src 2nd:\n%v\n", src)
 	if !src.Less(mid+1, mid) {
 		for i := lo; i <= hi; i++ {
 			dst.Set(i, src.Get(i))
-			return
 		}
-
+		return
 	}
-	fmt.Printf("after copy src: %v\n", src)
 	merge(src, dst, lo, mid, hi)
 }
 
@@ -185,7 +180,6 @@ func merge(src, dst Sorter, lo, mid, hi int) {
 			i = i + 1
 		}
 	}
-	fmt.Printf("Finished merging\n")
 }
 
 func QuickSort(data Sorter) {
@@ -226,37 +220,3 @@ func partition(data Sorter, lo, hi int) int {
 	data.Exch(lo, j)
 	return j
 }
-
-var filename string
-var sortType string
-
-func init() {
-	flag.StringVar(&filename, "f", "stdin", "filename or stdin.")
-	flag.StringVar(&sortType, "s", "selection", "sort type: selection, insertion, shell, merge.")
-}
-
-func main() {
-	var err error
-	var input *os.File
-
-	flag.Parse()
-	if filename == "stdin" {
-		input = os.Stdin
-	} else if input, err = os.Open(filename); err != nil {
-		log.Fatal(err)
-	}
-	sort := GetSortFunc(sortType)
-
-	helper := helpers.New(input)
-	strChan := make(chan string)
-	data := make(StringSlice, 0)
-
-	go helper.GetString(strChan)
-
-	for v := range strChan {
-		data = append(data, v)
-	}
-	fmt.Printf("we got our data: %v\n", data)
-	sort(data)
-	fmt.Printf("...and sorted: %v", data)
-}
@@ -0,0 +1,302 @@
+package sorting
+
+// Sort sorts s in place using pdqsort (pattern-defeating quicksort):
+// introsort's quicksort-with-heapsort-fallback, plus heuristics that let it
+// notice and exploit patterns in the input. less follows the slices.SortFunc
+// convention: it returns a negative number if a orders before b, zero if
+// they're equal, and a positive number if a orders after b.
+func Sort[T any](s []T, less func(a, b T) int) {
+	if len(s) < 2 {
+		return
+	}
+	limit := 2 * floorLog2(len(s))
+	pdqsort(s, less, limit)
+}
+
+// SortFunc is an alias for Sort, matching the naming slices.SortFunc uses.
+func SortFunc[T any](s []T, less func(a, b T) int) {
+	Sort(s, less)
+}
+
+// SortStable sorts s in place, preserving the relative order of elements
+// that compare equal. pdqsort isn't stable, so this falls back to a
+// bottom-up merge sort instead.
+func SortStable[T any](s []T, less func(a, b T) int) {
+	if len(s) < 2 {
+		return
+	}
+	aux := make([]T, len(s))
+	copy(aux, s)
+	stableMergeSort(aux, s, less)
+}
+
+// IsSorted reports whether s is sorted according to less.
+func IsSorted[T any](s []T, less func(a, b T) int) bool {
+	for i := 1; i < len(s); i++ {
+		if less(s[i], s[i-1]) < 0 {
+			return false
+		}
+	}
+	return true
+}
+
+const (
+	insertionThreshold = 12 // below this size, insertion sort beats quicksort's overhead.
+	shortestNinther    = 50
+)
+
+// floorLog2 returns floor(log2(n)) for n >= 1.
+func floorLog2(n int) int {
+	log := 0
+	for n > 1 {
+		n >>= 1
+		log++
+	}
+	return log
+}
+
+// pdqsort is the introsort driver: quicksort that falls back to heapsort
+// once the recursion depth budget (limit) is exhausted, with a cheap
+// already-sorted/reverse-sorted probe and a duplicate-heavy fallback to
+// three-way partitioning layered on top.
+func pdqsort[T any](s []T, less func(a, b T) int, limit int) {
+	for {
+		n := len(s)
+		if n <= insertionThreshold {
+			insertionSortGeneric(s, less)
+			return
+		}
+		if limit == 0 {
+			heapsort(s, less)
+			return
+		}
+		limit--
+
+		// Bail out to a cheap linear pass first: if s is already sorted, or
+		// sorted after reversing, we're done without ever partitioning.
+		if partialInsertionSort(s, less) {
+			return
+		}
+
+		pivot, likelySorted := choosePivot(s, less)
+		if likelySorted && partitionEquals(s, pivot, less) {
+			// Many elements equal the pivot: three-way partition them out
+			// so the next passes don't keep re-splitting the same run.
+			lo, hi := partitionThreeWay(s, pivot, less)
+			pdqsort(s[:lo], less, limit)
+			s = s[hi:]
+			continue
+		}
+
+		mid := pdqPartition(s, pivot, less)
+		// Recurse into the smaller half, loop (tail-call) on the larger one
+		// to keep stack depth at O(log n).
+		if mid < n-mid {
+			pdqsort(s[:mid], less, limit)
+			s = s[mid:]
+		} else {
+			pdqsort(s[mid:], less, limit)
+			s = s[:mid]
+		}
+	}
+}
+
+// choosePivot picks a pivot index via median-of-three (median-of-nine on
+// larger slices) and reports whether the probed samples were already
+// ordered, which is a cheap signal that the slice is heavy with duplicates
+// or nearly sorted around the pivot.
+func choosePivot[T any](s []T, less func(a, b T) int) (pivot int, likelySorted bool) {
+	n := len(s)
+	lo, mid, hi := 0, n/2, n-1
+	if n >= shortestNinther {
+		// ninther: median-of-three of three medians-of-three, spread across
+		// the slice so a few out-of-place elements can't fool the pivot.
+		step := n / 8
+		lo = medianOfThree(s, lo, lo+step, lo+2*step, less)
+		mid = medianOfThree(s, mid-step, mid, mid+step, less)
+		hi = medianOfThree(s, hi-2*step, hi-step, hi, less)
+	}
+	pivot = medianOfThree(s, lo, mid, hi, less)
+	likelySorted = less(s[lo], s[mid]) <= 0 && less(s[mid], s[hi]) <= 0
+	return pivot, likelySorted
+}
+
+// medianOfThree returns the index (among a, b, c) holding the median value,
+// swapping s[a], s[b], s[c] into sorted order as a side effect so callers
+// that probed a < b < c can reuse that ordering.
+func medianOfThree[T any](s []T, a, b, c int, less func(a, b T) int) int {
+	if less(s[b], s[a]) < 0 {
+		a, b = b, a
+	}
+	if less(s[c], s[b]) < 0 {
+		b, c = c, b
+		if less(s[b], s[a]) < 0 {
+			a, b = b, a
+		}
+	}
+	return b
+}
+
+// partition performs a Hoare-style partition around s[pivot], moving the
+// pivot to its final resting place and returning that index.
+func pdqPartition[T any](s []T, pivot int, less func(a, b T) int) int {
+	s[0], s[pivot] = s[pivot], s[0]
+	pv := s[0]
+	i, j := 1, len(s)-1
+	for {
+		for i <= j && less(s[i], pv) < 0 {
+			i++
+		}
+		for i <= j && less(pv, s[j]) < 0 {
+			j--
+		}
+		if i > j {
+			break
+		}
+		s[i], s[j] = s[j], s[i]
+		i++
+		j--
+	}
+	s[0], s[j] = s[j], s[0]
+	return j
+}
+
+// partitionEquals checks whether the pivot value repeats often enough in s
+// to justify a three-way partition instead of another two-way split.
+func partitionEquals[T any](s []T, pivot int, less func(a, b T) int) bool {
+	pv := s[pivot]
+	dupes := 0
+	step := len(s)/8 + 1
+	for i := 0; i < len(s); i += step {
+		if less(s[i], pv) == 0 {
+			dupes++
+		}
+	}
+	return dupes >= 2
+}
+
+// partitionThreeWay partitions s into [<pivot | ==pivot | >pivot] (Dutch
+// national flag) and returns the [lo, hi) bounds of the equal run, which
+// pdqsort skips re-sorting.
+func partitionThreeWay[T any](s []T, pivot int, less func(a, b T) int) (lo, hi int) {
+	pv := s[pivot]
+	lt, i, gt := 0, 0, len(s)-1
+	for i <= gt {
+		switch c := less(s[i], pv); {
+		case c < 0:
+			s[lt], s[i] = s[i], s[lt]
+			lt++
+			i++
+		case c > 0:
+			s[i], s[gt] = s[gt], s[i]
+			gt--
+		default:
+			i++
+		}
+	}
+	return lt, gt + 1
+}
+
+// partialInsertionSort tries an insertion sort but bails out after a fixed
+// budget of element moves, so it's cheap to attempt on every partition yet
+// still detects (and finishes) already-sorted or nearly-sorted runs.
+func partialInsertionSort[T any](s []T, less func(a, b T) int) bool {
+	const maxMoves = 5
+	n := len(s)
+	if n < 2 {
+		return true
+	}
+	moves := 0
+	for i := 1; i < n; i++ {
+		if less(s[i], s[i-1]) >= 0 {
+			continue
+		}
+		j := i
+		for j > 0 && less(s[j], s[j-1]) < 0 {
+			s[j], s[j-1] = s[j-1], s[j]
+			j--
+			moves++
+			if moves > maxMoves {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func insertionSortGeneric[T any](s []T, less func(a, b T) int) {
+	for i := 1; i < len(s); i++ {
+		for j := i; j > 0 && less(s[j], s[j-1]) < 0; j-- {
+			s[j], s[j-1] = s[j-1], s[j]
+		}
+	}
+}
+
+// heapsort is pdqsort's O(n log n) worst-case fallback once the recursion
+// depth budget runs out.
+func heapsort[T any](s []T, less func(a, b T) int) {
+	n := len(s)
+	for i := n/2 - 1; i >= 0; i-- {
+		siftDown(s, i, n, less)
+	}
+	for i := n - 1; i > 0; i-- {
+		s[0], s[i] = s[i], s[0]
+		siftDown(s, 0, i, less)
+	}
+}
+
+func siftDown[T any](s []T, root, n int, less func(a, b T) int) {
+	for {
+		child := 2*root + 1
+		if child >= n {
+			return
+		}
+		if child+1 < n && less(s[child], s[child+1]) < 0 {
+			child++
+		}
+		if less(s[root], s[child]) >= 0 {
+			return
+		}
+		s[root], s[child] = s[child], s[root]
+		root = child
+	}
+}
+
+// stableMergeSort is the same src/dst ping-pong merge sort legacy.go uses
+// for MergeSort, generified: the recursive call swaps src and dst so that,
+// by induction, src[lo:hi] ends up sorted and ready to merge into dst.
+func stableMergeSort[T any](src, dst []T, less func(a, b T) int) {
+	n := len(dst)
+	if n <= insertionThreshold {
+		insertionSortGeneric(dst, less)
+		return
+	}
+	mid := n / 2
+	stableMergeSort(dst[:mid], src[:mid], less)
+	stableMergeSort(dst[mid:], src[mid:], less)
+	if less(src[mid], src[mid-1]) >= 0 {
+		copy(dst, src)
+		return
+	}
+	mergeStable(src, dst, mid, less)
+}
+
+func mergeStable[T any](src, dst []T, mid int, less func(a, b T) int) {
+	i, j := 0, mid
+	for k := range dst {
+		switch {
+		case i >= mid:
+			dst[k] = src[j]
+			j++
+		case j >= len(src):
+			dst[k] = src[i]
+			i++
+		case less(src[j], src[i]) < 0:
+			dst[k] = src[j]
+			j++
+		default:
+			dst[k] = src[i]
+			i++
+		}
+	}
+}
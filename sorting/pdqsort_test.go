@@ -0,0 +1,154 @@
+package sorting
+
+import (
+	"math/rand"
+	"slices"
+	"sort"
+	"testing"
+)
+
+func intLess(a, b int) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+func TestSortMatchesStdlib(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for trial := 0; trial < 200; trial++ {
+		n := rng.Intn(500)
+		s := make([]int, n)
+		for i := range s {
+			s[i] = rng.Intn(50) - 25
+		}
+		want := append([]int(nil), s...)
+		sort.Ints(want)
+
+		got := append([]int(nil), s...)
+		Sort(got, intLess)
+		if !slices.Equal(got, want) {
+			t.Fatalf("trial %d: Sort(%v) = %v, want %v", trial, s, got, want)
+		}
+		if !IsSorted(got, intLess) {
+			t.Fatalf("trial %d: IsSorted reported false for sorted slice %v", trial, got)
+		}
+	}
+}
+
+func TestSortStablePreservesOrder(t *testing.T) {
+	type pair struct{ key, orig int }
+	rng := rand.New(rand.NewSource(2))
+
+	n := 1000
+	s := make([]pair, n)
+	for i := range s {
+		s[i] = pair{key: rng.Intn(10), orig: i}
+	}
+	SortStable(s, func(a, b pair) int { return intLess(a.key, b.key) })
+
+	for i := 1; i < n; i++ {
+		if s[i].key < s[i-1].key {
+			t.Fatalf("index %d: not sorted by key: %+v then %+v", i, s[i-1], s[i])
+		}
+		if s[i].key == s[i-1].key && s[i].orig < s[i-1].orig {
+			t.Fatalf("index %d: stability violated within equal key %d: orig %d then %d", i, s[i].key, s[i-1].orig, s[i].orig)
+		}
+	}
+}
+
+func randomInts(rng *rand.Rand, n int) []int {
+	s := make([]int, n)
+	for i := range s {
+		s[i] = rng.Intn(n)
+	}
+	return s
+}
+
+func sortedInts(n int) []int {
+	s := make([]int, n)
+	for i := range s {
+		s[i] = i
+	}
+	return s
+}
+
+func reverseInts(n int) []int {
+	s := make([]int, n)
+	for i := range s {
+		s[i] = n - i
+	}
+	return s
+}
+
+func dupHeavyInts(n int) []int {
+	s := make([]int, n)
+	for i := range s {
+		s[i] = i % 8
+	}
+	return s
+}
+
+var benchInputs = map[string]func(rng *rand.Rand, n int) []int{
+	"random":     randomInts,
+	"sorted":     func(_ *rand.Rand, n int) []int { return sortedInts(n) },
+	"reverse":    func(_ *rand.Rand, n int) []int { return reverseInts(n) },
+	"duplicates": func(_ *rand.Rand, n int) []int { return dupHeavyInts(n) },
+}
+
+const benchSize = 100_000
+
+func BenchmarkSort(b *testing.B) {
+	rng := rand.New(rand.NewSource(3))
+	for name, gen := range benchInputs {
+		b.Run(name, func(b *testing.B) {
+			base := gen(rng, benchSize)
+			s := make([]int, benchSize)
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				b.StopTimer()
+				copy(s, base)
+				b.StartTimer()
+				Sort(s, intLess)
+			}
+		})
+	}
+}
+
+func BenchmarkSortSlice(b *testing.B) {
+	rng := rand.New(rand.NewSource(3))
+	for name, gen := range benchInputs {
+		b.Run(name, func(b *testing.B) {
+			base := gen(rng, benchSize)
+			s := make([]int, benchSize)
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				b.StopTimer()
+				copy(s, base)
+				b.StartTimer()
+				sort.Slice(s, func(i, j int) bool { return s[i] < s[j] })
+			}
+		})
+	}
+}
+
+func BenchmarkSlicesSort(b *testing.B) {
+	rng := rand.New(rand.NewSource(3))
+	for name, gen := range benchInputs {
+		b.Run(name, func(b *testing.B) {
+			base := gen(rng, benchSize)
+			s := make([]int, benchSize)
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				b.StopTimer()
+				copy(s, base)
+				b.StartTimer()
+				slices.Sort(s)
+			}
+		})
+	}
+}
@@ -0,0 +1,253 @@
+package unionfind
+
+// PathCompressedUF is a quick-union variant that compresses paths during
+// Find by path halving: every other node visited on the way to the root is
+// re-pointed at its grandparent. Unlike WeightedQuickUnion it unions by
+// index rather than by size, so path compression is what keeps trees flat.
+// sz is tracked purely so Size/Components can answer; it plays no part in
+// which root a union points at.
+type PathCompressedUF struct {
+	id    []int64
+	sz    []int64
+	count int64
+}
+
+func (UF *PathCompressedUF) Init(N int64) {
+	UF.id = make([]int64, N)
+	UF.sz = make([]int64, N)
+	for i := int64(0); i < N; i++ {
+		UF.id[i] = i
+		UF.sz[i] = 1
+	}
+	UF.count = N
+}
+
+func (UF *PathCompressedUF) Count() int64 {
+	return UF.count
+}
+
+func (UF *PathCompressedUF) Connected(p, q int64) bool {
+	return UF.Find(p) == UF.Find(q)
+}
+
+// Find walks to the root, halving the path length along the way by
+// re-pointing each visited node at its grandparent.
+func (UF *PathCompressedUF) Find(p int64) int64 {
+	for UF.id[p] != p {
+		UF.id[p] = UF.id[UF.id[p]]
+		p = UF.id[p]
+	}
+	return p
+}
+
+func (UF *PathCompressedUF) Union(p, q int64) {
+	rootP := UF.Find(p)
+	rootQ := UF.Find(q)
+	if rootP == rootQ {
+		return
+	}
+	UF.id[rootP] = rootQ
+	UF.sz[rootQ] += UF.sz[rootP]
+	UF.count--
+}
+
+// Size returns the number of sites in p's component.
+func (UF *PathCompressedUF) Size(p int64) int64 {
+	return UF.sz[UF.Find(p)]
+}
+
+// Components enumerates the sites making up each component, keyed by root.
+func (UF *PathCompressedUF) Components() [][]int64 {
+	groups := make(map[int64][]int64)
+	for i := range UF.id {
+		root := UF.Find(int64(i))
+		groups[root] = append(groups[root], int64(i))
+	}
+	return groups2slice(groups)
+}
+
+// UnionByRank combines union-by-rank with full path compression during Find
+// to achieve the standard near-O(α(n)) amortized cost per operation. Rank is
+// an upper bound on tree height and is kept separate from component size,
+// which is tracked in sz purely so Size/Components can answer in O(1)/O(N).
+type UnionByRank struct {
+	id    []int64
+	rank  []int64
+	sz    []int64
+	count int64
+}
+
+func (UF *UnionByRank) Init(N int64) {
+	UF.id = make([]int64, N)
+	UF.rank = make([]int64, N)
+	UF.sz = make([]int64, N)
+	for i := int64(0); i < N; i++ {
+		UF.id[i] = i
+		UF.sz[i] = 1
+	}
+	UF.count = N
+}
+
+func (UF *UnionByRank) Count() int64 {
+	return UF.count
+}
+
+func (UF *UnionByRank) Connected(p, q int64) bool {
+	return UF.Find(p) == UF.Find(q)
+}
+
+// Find walks to the root then makes every visited node point directly at it.
+func (UF *UnionByRank) Find(p int64) int64 {
+	root := p
+	for UF.id[root] != root {
+		root = UF.id[root]
+	}
+	for p != root {
+		p, UF.id[p] = UF.id[p], root
+	}
+	return root
+}
+
+func (UF *UnionByRank) Union(p, q int64) {
+	rootP := UF.Find(p)
+	rootQ := UF.Find(q)
+	if rootP == rootQ {
+		return
+	}
+	switch {
+	case UF.rank[rootP] < UF.rank[rootQ]:
+		UF.id[rootP] = rootQ
+		UF.sz[rootQ] += UF.sz[rootP]
+	case UF.rank[rootP] > UF.rank[rootQ]:
+		UF.id[rootQ] = rootP
+		UF.sz[rootP] += UF.sz[rootQ]
+	default:
+		UF.id[rootQ] = rootP
+		UF.sz[rootP] += UF.sz[rootQ]
+		UF.rank[rootP]++
+	}
+	UF.count--
+}
+
+func (UF *UnionByRank) Size(p int64) int64 {
+	return UF.sz[UF.Find(p)]
+}
+
+func (UF *UnionByRank) Components() [][]int64 {
+	groups := make(map[int64][]int64)
+	for i := range UF.id {
+		root := UF.Find(int64(i))
+		groups[root] = append(groups[root], int64(i))
+	}
+	return groups2slice(groups)
+}
+
+// rollbackOp records the single mutation a Union call made to index idx, so
+// Rollback can restore it exactly.
+type rollbackOp struct {
+	idx       int64
+	oldParent int64
+	oldSize   int64
+	noop      bool
+}
+
+// RollbackUF is a union-by-size union-find that never path-compresses, so
+// every Union it performs can be undone in O(1) via Rollback. That exactness
+// is what makes it suitable for offline algorithms that must process unions
+// in an order other than the one they arrived in, e.g. answering
+// connectivity queries by re-adding edges in reverse of their deletion
+// order, then rolling back once the queries at an earlier time are reached.
+type RollbackUF struct {
+	id    []int64
+	sz    []int64
+	count int64
+	ops   []rollbackOp
+}
+
+func (UF *RollbackUF) Init(N int64) {
+	UF.id = make([]int64, N)
+	UF.sz = make([]int64, N)
+	for i := int64(0); i < N; i++ {
+		UF.id[i] = i
+		UF.sz[i] = 1
+	}
+	UF.count = N
+	UF.ops = nil
+}
+
+func (UF *RollbackUF) Count() int64 {
+	return UF.count
+}
+
+func (UF *RollbackUF) Connected(p, q int64) bool {
+	return UF.Find(p) == UF.Find(q)
+}
+
+// Find never compresses paths: doing so would make Rollback unable to
+// restore the exact parent pointers Union recorded.
+func (UF *RollbackUF) Find(p int64) int64 {
+	for UF.id[p] != p {
+		p = UF.id[p]
+	}
+	return p
+}
+
+func (UF *RollbackUF) Union(p, q int64) {
+	rootP := UF.Find(p)
+	rootQ := UF.Find(q)
+	if rootP == rootQ {
+		UF.ops = append(UF.ops, rollbackOp{noop: true})
+		return
+	}
+	// union by size, smaller root hangs off the larger one.
+	if UF.sz[rootP] > UF.sz[rootQ] {
+		rootP, rootQ = rootQ, rootP
+	}
+	UF.ops = append(UF.ops, rollbackOp{idx: rootP, oldParent: UF.id[rootP], oldSize: UF.sz[rootQ]})
+	UF.id[rootP] = rootQ
+	UF.sz[rootQ] += UF.sz[rootP]
+	UF.count--
+}
+
+// Snapshot returns a mark that Rollback can later return to.
+func (UF *RollbackUF) Snapshot() int {
+	return len(UF.ops)
+}
+
+// Rollback undoes every Union performed since mark, restoring parents, sizes
+// and count to exactly what they were at that point.
+func (UF *RollbackUF) Rollback(mark int) {
+	for len(UF.ops) > mark {
+		op := UF.ops[len(UF.ops)-1]
+		UF.ops = UF.ops[:len(UF.ops)-1]
+		if op.noop {
+			continue
+		}
+		UF.sz[UF.id[op.idx]] = op.oldSize
+		UF.id[op.idx] = op.oldParent
+		UF.count++
+	}
+}
+
+func (UF *RollbackUF) Size(p int64) int64 {
+	return UF.sz[UF.Find(p)]
+}
+
+func (UF *RollbackUF) Components() [][]int64 {
+	groups := make(map[int64][]int64)
+	for i := range UF.id {
+		root := UF.Find(int64(i))
+		groups[root] = append(groups[root], int64(i))
+	}
+	return groups2slice(groups)
+}
+
+// groups2slice flattens a root->sites map into the [][]int64 Components()
+// implementations return.
+func groups2slice(groups map[int64][]int64) [][]int64 {
+	out := make([][]int64, 0, len(groups))
+	for _, sites := range groups {
+		out = append(out, sites)
+	}
+	return out
+}
@@ -0,0 +1,146 @@
+package unionfind
+
+import "github.com/wirepair/algorithms/sorting"
+
+// ConnEdge is a plain, unweighted edge used by the offline connectivity
+// queries below.
+type ConnEdge struct {
+	U, V int64
+}
+
+// Query asks whether U and V are connected at Time.
+type Query struct {
+	Time int
+	U, V int64
+}
+
+// SolveOfflineConnectivity answers connectivity queries on a graph whose
+// edges are all present from the start and removed at various times, using
+// the classic "process queries in reverse, unioning deletions as you cross
+// them" trick: edges that are never removed are unioned up front as
+// permanent, then queries are walked from the last back to the first,
+// re-adding each edge (via Union) exactly when time drops below the point
+// it was deleted.
+//
+// deletions[i] is the query time at which edges[i] is removed, or -1 if
+// it's never removed. Answers are returned in the same order as queries.
+func SolveOfflineConnectivity(n int64, edges []ConnEdge, deletions []int, queries []Query) []bool {
+	uf := new(WeightedQuickUnion)
+	uf.Init(n)
+
+	byDeletion := make(map[int][]ConnEdge)
+	for i, e := range edges {
+		if deletions[i] == -1 {
+			uf.Union(e.U, e.V)
+		} else {
+			byDeletion[deletions[i]] = append(byDeletion[deletions[i]], e)
+		}
+	}
+
+	order := make([]int, len(queries))
+	for i := range order {
+		order[i] = i
+	}
+	sorting.Sort(order, func(a, b int) int {
+		return queries[b].Time - queries[a].Time // descending by Time.
+	})
+
+	answers := make([]bool, len(queries))
+	lastTime := -1
+	for _, idx := range order {
+		q := queries[idx]
+		if lastTime == -1 {
+			lastTime = q.Time
+		}
+		// crossing down past every time in (q.Time, lastTime] re-adds
+		// whatever was deleted exactly at that time.
+		for t := lastTime; t > q.Time; t-- {
+			for _, e := range byDeletion[t] {
+				uf.Union(e.U, e.V)
+			}
+		}
+		answers[idx] = uf.Connected(q.U, q.V)
+		lastTime = q.Time
+	}
+	return answers
+}
+
+// DynamicEdge is present during the half-open time interval [Start, End).
+type DynamicEdge struct {
+	U, V       int64
+	Start, End int
+}
+
+// segNode covers a half-open time range and stores the edges whose active
+// interval fully covers that range (the canonical decomposition of an
+// edge's [Start, End) interval touches O(log T) such nodes).
+type segNode struct {
+	edges []DynamicEdge
+}
+
+// SolveDynamicConnectivity answers connectivity queries over a timeline of
+// T discrete time steps [0, T) where edges may be added and removed
+// arbitrarily, using a segment tree over time plus RollbackUF: each edge's
+// active interval is decomposed into O(log T) segment tree nodes, a DFS
+// unions a node's edges on the way down and rolls them back (via
+// Rollback/Snapshot) on the way up, and queries are answered at their own
+// time step while every edge covering it is still unioned.
+func SolveDynamicConnectivity(n int64, t int, edges []DynamicEdge, queries []Query) []bool {
+	answers := make([]bool, len(queries))
+	if t <= 0 {
+		return answers
+	}
+
+	tree := make([]segNode, 4*t)
+	var insert func(node, lo, hi, qlo, qhi int, e DynamicEdge)
+	insert = func(node, lo, hi, qlo, qhi int, e DynamicEdge) {
+		if qhi <= lo || hi <= qlo {
+			return
+		}
+		if qlo <= lo && hi <= qhi {
+			tree[node].edges = append(tree[node].edges, e)
+			return
+		}
+		mid := (lo + hi) / 2
+		insert(2*node+1, lo, mid, qlo, qhi, e)
+		insert(2*node+2, mid, hi, qlo, qhi, e)
+	}
+	for _, e := range edges {
+		start, end := e.Start, e.End
+		if end > t {
+			end = t
+		}
+		if start < end {
+			insert(0, 0, t, start, end, e)
+		}
+	}
+
+	byTime := make(map[int][]int) // query time -> indices into queries.
+	for i, q := range queries {
+		byTime[q.Time] = append(byTime[q.Time], i)
+	}
+
+	uf := new(RollbackUF)
+	uf.Init(n)
+
+	var dfs func(node, lo, hi int)
+	dfs = func(node, lo, hi int) {
+		mark := uf.Snapshot()
+		for _, e := range tree[node].edges {
+			uf.Union(e.U, e.V)
+		}
+		if hi-lo == 1 {
+			for _, qi := range byTime[lo] {
+				q := queries[qi]
+				answers[qi] = uf.Connected(q.U, q.V)
+			}
+		} else {
+			mid := (lo + hi) / 2
+			dfs(2*node+1, lo, mid)
+			dfs(2*node+2, mid, hi)
+		}
+		uf.Rollback(mark)
+	}
+	dfs(0, 0, t)
+	return answers
+}
@@ -0,0 +1,107 @@
+package unionfind
+
+import (
+	"math/rand"
+	"testing"
+)
+
+// bfsConnected reports whether p and q are connected in the graph formed by
+// edges, via plain BFS. It's the brute-force oracle the offline/dynamic
+// connectivity solvers below are checked against.
+func bfsConnected(n int64, edges [][2]int64, p, q int64) bool {
+	if p == q {
+		return true
+	}
+	adj := make(map[int64][]int64, n)
+	for _, e := range edges {
+		adj[e[0]] = append(adj[e[0]], e[1])
+		adj[e[1]] = append(adj[e[1]], e[0])
+	}
+	visited := map[int64]bool{p: true}
+	queue := []int64{p}
+	for len(queue) > 0 {
+		cur := queue[0]
+		queue = queue[1:]
+		if cur == q {
+			return true
+		}
+		for _, next := range adj[cur] {
+			if !visited[next] {
+				visited[next] = true
+				queue = append(queue, next)
+			}
+		}
+	}
+	return false
+}
+
+func TestSolveOfflineConnectivity(t *testing.T) {
+	const n = 20
+	const maxTime = 10
+	rng := rand.New(rand.NewSource(1))
+
+	edges := make([]ConnEdge, 30)
+	deletions := make([]int, len(edges))
+	for i := range edges {
+		edges[i] = ConnEdge{U: rng.Int63n(n), V: rng.Int63n(n)}
+		if rng.Intn(3) == 0 {
+			deletions[i] = -1
+		} else {
+			deletions[i] = rng.Intn(maxTime + 1)
+		}
+	}
+
+	queries := make([]Query, 50)
+	for i := range queries {
+		queries[i] = Query{Time: rng.Intn(maxTime + 1), U: rng.Int63n(n), V: rng.Int63n(n)}
+	}
+
+	got := SolveOfflineConnectivity(n, edges, deletions, queries)
+
+	for i, q := range queries {
+		var present [][2]int64
+		for j, e := range edges {
+			// an edge deleted at time d is absent from time d onward.
+			if deletions[j] == -1 || q.Time < deletions[j] {
+				present = append(present, [2]int64{e.U, e.V})
+			}
+		}
+		want := bfsConnected(n, present, q.U, q.V)
+		if got[i] != want {
+			t.Fatalf("query %d (time=%d, %d-%d): got %v, want %v", i, q.Time, q.U, q.V, got[i], want)
+		}
+	}
+}
+
+func TestSolveDynamicConnectivity(t *testing.T) {
+	const n = 20
+	const maxTime = 16
+	rng := rand.New(rand.NewSource(2))
+
+	edges := make([]DynamicEdge, 30)
+	for i := range edges {
+		start := rng.Intn(maxTime)
+		end := start + 1 + rng.Intn(maxTime-start)
+		edges[i] = DynamicEdge{U: rng.Int63n(n), V: rng.Int63n(n), Start: start, End: end}
+	}
+
+	queries := make([]Query, 50)
+	for i := range queries {
+		queries[i] = Query{Time: rng.Intn(maxTime), U: rng.Int63n(n), V: rng.Int63n(n)}
+	}
+
+	got := SolveDynamicConnectivity(n, maxTime, edges, queries)
+
+	for i, q := range queries {
+		var present [][2]int64
+		for _, e := range edges {
+			if e.Start <= q.Time && q.Time < e.End {
+				present = append(present, [2]int64{e.U, e.V})
+			}
+		}
+		want := bfsConnected(n, present, q.U, q.V)
+		if got[i] != want {
+			t.Fatalf("query %d (time=%d, %d-%d): got %v, want %v", i, q.Time, q.U, q.V, got[i], want)
+		}
+	}
+}
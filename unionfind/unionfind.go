@@ -1,14 +1,10 @@
-package main
+// Package unionfind provides several union-find (disjoint-set) implementations
+// ranging from the textbook quick-find/quick-union progression to variants
+// used by competitive-programming style offline algorithms (path compression,
+// union by rank, and a rollback variant for undoable unions).
+package unionfind
 
-import (
-	"flag"
-	"fmt"
-	"github.com/wirepair/algorithms/helpers"
-	"log"
-	"os"
-)
-
-// UnionFind interface used for implementations of
+// UnionFinder interface used for implementations of
 // quick-find, quick-union and weighted quick-union.
 type UnionFinder interface {
 	Init(N int64)              // Sets number of sites.
@@ -18,6 +14,14 @@ type UnionFinder interface {
 	Find(p int64) int64        // Finds the value of a site in our site list.
 }
 
+// SizedUnionFinder is a UnionFinder that also tracks component sizes, for
+// implementations that maintain this data as part of unioning anyway.
+type SizedUnionFinder interface {
+	UnionFinder
+	Size(p int64) int64    // Size returns the number of sites in p's component.
+	Components() [][]int64 // Components enumerates the sites that make up each component.
+}
+
 // An implementation of Quick-Find
 type UnionQuickFind struct {
 	id    []int64 // site array.
@@ -43,7 +47,6 @@ func (UF *UnionQuickFind) Count() int64 {
 
 // checks if p is connected to q
 func (UF *UnionQuickFind) Connected(p, q int64) bool {
-	//fmt.Printf("p: %d q: %d connected? %v\n", p, q, UF.Find(p) == UF.Find(q))
 	return UF.Find(p) == UF.Find(q)
 }
 
@@ -64,7 +67,6 @@ func (UF *UnionQuickFind) Union(p, q int64) {
 			UF.id[i] = rootQ
 		}
 	}
-	//fmt.Printf("%#v\n", UF.id)
 	UF.count--
 }
 
@@ -76,7 +78,7 @@ func (UF *UnionQuickFind) Find(p int64) int64 {
 
 // An implementation of Quick-Union
 type QuickUnionFind struct {
-	UnionQuickFind // embeds id, count, Init, Connected and Count.
+	UnionQuickFind // embeds id, count and Init.
 }
 
 // iterates when p does not equal the value of
@@ -89,6 +91,15 @@ func (UF *QuickUnionFind) Find(p int64) int64 {
 	return p
 }
 
+// Connected overrides UnionQuickFind.Connected. Embedding doesn't give
+// virtual dispatch, so without this override, calling Connected on a
+// QuickUnionFind (or WeightedQuickUnion, which embeds this type) would run
+// UnionQuickFind's flat id[p] == id[q] check instead of chasing roots via
+// this type's own path-following Find.
+func (UF *QuickUnionFind) Connected(p, q int64) bool {
+	return UF.Find(p) == UF.Find(q)
+}
+
 // finds p/q and updates the site index at i
 // to the value of j. This creates a linked list
 // like structure where each site points to
@@ -100,7 +111,6 @@ func (UF *QuickUnionFind) Union(p, q int64) {
 		return
 	}
 	UF.id[i] = j
-	//fmt.Printf("%#v\n", UF.id)
 	UF.count--
 }
 
@@ -140,69 +150,21 @@ func (UF *WeightedQuickUnion) Union(p, q int64) {
 	UF.count--
 }
 
-var filename string
-var ufType string
-
-func init() {
-	flag.StringVar(&filename, "f", "stdin", "filename or stdin.")
-	flag.StringVar(&ufType, "u", "weighted", "unionfind type: quickfind, quickunion, weighted")
-}
-
-// Creates a UnionFinder based on the requested type.
-func getFinder() UnionFinder {
-	var uf UnionFinder
-	switch ufType {
-	case "weighted":
-		uf = new(WeightedQuickUnion)
-	case "quickfind":
-		uf = new(UnionQuickFind)
-	case "quickunion":
-		uf = new(QuickUnionFind)
-	default:
-		log.Fatal("error must choose a type of quickfind, quickunion or weighted")
-	}
-	return uf
+// Size returns the number of sites in p's component.
+func (UF *WeightedQuickUnion) Size(p int64) int64 {
+	return UF.sz[UF.Find(p)]
 }
 
-func main() {
-	var err error
-	var input *os.File
-	flag.Parse()
-	if filename == "stdin" {
-		input = os.Stdin
-	} else if input, err = os.Open(filename); err != nil {
-		log.Fatal(err)
-		return
-	}
-	fmt.Printf("Opened %s for input.\n", filename)
-
-	helper := helpers.New(input)
-	sites, err := helper.GetSites()
-	if err != nil {
-		log.Fatal(err)
+// Components enumerates the sites making up each component, keyed by root.
+func (UF *WeightedQuickUnion) Components() [][]int64 {
+	groups := make(map[int64][]int64)
+	for i := range UF.id {
+		root := UF.Find(int64(i))
+		groups[root] = append(groups[root], int64(i))
 	}
-
-	uf := getFinder()
-	fmt.Printf("Using unionfind of type %s.\n", ufType)
-
-	uf.Init(sites)
-
-	intChan := make(chan int64)
-
-	go func() {
-		helper.GetInt(intChan)
-	}()
-
-	for p := range intChan {
-		q := <-intChan
-		//fmt.Printf("%d %d\n", p, q)
-		if uf.Connected(p, q) {
-			//fmt.Printf("%d %d are not connected.\n", p, q)
-			continue
-		}
-		uf.Union(p, q)
-
+	out := make([][]int64, 0, len(groups))
+	for _, sites := range groups {
+		out = append(out, sites)
 	}
-	fmt.Printf("%d components.\n", uf.Count())
-	helper.Close()
+	return out
 }
@@ -0,0 +1,166 @@
+package unionfind
+
+import (
+	"math/rand"
+	"testing"
+)
+
+// bruteForceUF answers Connected by BFS over every union recorded so far. It
+// is deliberately not a union-find at all, so it can act as an independent
+// reference for checking the real implementations against.
+type bruteForceUF struct {
+	edges [][2]int64
+}
+
+func (b *bruteForceUF) union(p, q int64) {
+	b.edges = append(b.edges, [2]int64{p, q})
+}
+
+func (b *bruteForceUF) connected(p, q int64) bool {
+	if p == q {
+		return true
+	}
+	adj := make(map[int64][]int64)
+	for _, e := range b.edges {
+		adj[e[0]] = append(adj[e[0]], e[1])
+		adj[e[1]] = append(adj[e[1]], e[0])
+	}
+	visited := map[int64]bool{p: true}
+	queue := []int64{p}
+	for len(queue) > 0 {
+		cur := queue[0]
+		queue = queue[1:]
+		if cur == q {
+			return true
+		}
+		for _, next := range adj[cur] {
+			if !visited[next] {
+				visited[next] = true
+				queue = append(queue, next)
+			}
+		}
+	}
+	return false
+}
+
+// TestVariantsAgreeWithBruteForce runs a randomized sequence of unions and
+// connectivity checks against every UnionFinder implementation and a
+// brute-force BFS reference, failing as soon as any implementation
+// disagrees with the reference.
+func TestVariantsAgreeWithBruteForce(t *testing.T) {
+	const n = 200
+	rng := rand.New(rand.NewSource(1))
+
+	variants := map[string]UnionFinder{
+		"quickfind":      new(UnionQuickFind),
+		"quickunion":     new(QuickUnionFind),
+		"weighted":       new(WeightedQuickUnion),
+		"pathcompressed": new(PathCompressedUF),
+		"rank":           new(UnionByRank),
+		"rollback":       new(RollbackUF),
+	}
+	for _, uf := range variants {
+		uf.Init(n)
+	}
+	brute := &bruteForceUF{}
+
+	for i := 0; i < 2000; i++ {
+		p := rng.Int63n(n)
+		q := rng.Int63n(n)
+		if rng.Intn(4) == 0 {
+			want := brute.connected(p, q)
+			for name, uf := range variants {
+				if got := uf.Connected(p, q); got != want {
+					t.Fatalf("%s: Connected(%d, %d) = %v, want %v (iteration %d)", name, p, q, got, want, i)
+				}
+			}
+			continue
+		}
+		brute.union(p, q)
+		for _, uf := range variants {
+			uf.Union(p, q)
+		}
+	}
+}
+
+// TestSizedVariantsAgree checks Size and Components against the reference
+// for the implementations that support them.
+func TestSizedVariantsAgree(t *testing.T) {
+	const n = 100
+	rng := rand.New(rand.NewSource(2))
+
+	variants := map[string]SizedUnionFinder{
+		"weighted":       new(WeightedQuickUnion),
+		"pathcompressed": new(PathCompressedUF),
+		"rank":           new(UnionByRank),
+		"rollback":       new(RollbackUF),
+	}
+	for _, uf := range variants {
+		uf.Init(n)
+	}
+	brute := &bruteForceUF{}
+
+	for i := 0; i < 500; i++ {
+		p := rng.Int63n(n)
+		q := rng.Int63n(n)
+		brute.union(p, q)
+		for _, uf := range variants {
+			uf.Union(p, q)
+		}
+	}
+
+	for p := int64(0); p < n; p++ {
+		var want int64
+		for q := int64(0); q < n; q++ {
+			if brute.connected(p, q) {
+				want++
+			}
+		}
+		for name, uf := range variants {
+			if got := uf.Size(p); got != want {
+				t.Fatalf("%s: Size(%d) = %d, want %d", name, p, got, want)
+			}
+		}
+	}
+
+	for name, uf := range variants {
+		total := int64(0)
+		for _, sites := range uf.Components() {
+			total += int64(len(sites))
+		}
+		if total != n {
+			t.Fatalf("%s: Components() covers %d sites, want %d", name, total, n)
+		}
+	}
+}
+
+func benchmarkUnions(b *testing.B, newUF func() UnionFinder) {
+	const n = 1_000_000
+	const ops = 5_000_000
+	rng := rand.New(rand.NewSource(3))
+	pairs := make([][2]int64, ops)
+	for i := range pairs {
+		pairs[i] = [2]int64{rng.Int63n(n), rng.Int63n(n)}
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		uf := newUF()
+		uf.Init(n)
+		for _, p := range pairs {
+			uf.Union(p[0], p[1])
+		}
+	}
+}
+
+func BenchmarkPathCompressedUFUnions(b *testing.B) {
+	benchmarkUnions(b, func() UnionFinder { return new(PathCompressedUF) })
+}
+
+func BenchmarkUnionByRankUnions(b *testing.B) {
+	benchmarkUnions(b, func() UnionFinder { return new(UnionByRank) })
+}
+
+func BenchmarkRollbackUFUnions(b *testing.B) {
+	benchmarkUnions(b, func() UnionFinder { return new(RollbackUF) })
+}